@@ -0,0 +1,103 @@
+package disgord
+
+// RoleID identifies a Role. It is a Snowflake restricted to role-shaped call sites, so passing a
+// GuildID or ChannelID where a role is expected is caught by the compiler instead of at runtime.
+type RoleID Snowflake
+
+func (id RoleID) String() string {
+	return Snowflake(id).String()
+}
+
+func (id RoleID) IsZero() bool {
+	return Snowflake(id).IsZero()
+}
+
+func (id RoleID) MarshalJSON() ([]byte, error) {
+	return Snowflake(id).MarshalJSON()
+}
+
+func (id *RoleID) UnmarshalJSON(data []byte) error {
+	return (*Snowflake)(id).UnmarshalJSON(data)
+}
+
+// GuildID identifies a Guild. It is a Snowflake restricted to guild-shaped call sites, so passing
+// a RoleID or ChannelID where a guild is expected is caught by the compiler instead of at runtime.
+type GuildID Snowflake
+
+func (id GuildID) String() string {
+	return Snowflake(id).String()
+}
+
+func (id GuildID) IsZero() bool {
+	return Snowflake(id).IsZero()
+}
+
+func (id GuildID) MarshalJSON() ([]byte, error) {
+	return Snowflake(id).MarshalJSON()
+}
+
+func (id *GuildID) UnmarshalJSON(data []byte) error {
+	return (*Snowflake)(id).UnmarshalJSON(data)
+}
+
+// ChannelID identifies a Channel. It is a Snowflake restricted to channel-shaped call sites, so
+// passing a GuildID or RoleID where a channel is expected is caught by the compiler instead of at
+// runtime.
+type ChannelID Snowflake
+
+func (id ChannelID) String() string {
+	return Snowflake(id).String()
+}
+
+func (id ChannelID) IsZero() bool {
+	return Snowflake(id).IsZero()
+}
+
+func (id ChannelID) MarshalJSON() ([]byte, error) {
+	return Snowflake(id).MarshalJSON()
+}
+
+func (id *ChannelID) UnmarshalJSON(data []byte) error {
+	return (*Snowflake)(id).UnmarshalJSON(data)
+}
+
+// UserID identifies a User. It is a Snowflake restricted to user-shaped call sites, so passing a
+// RoleID or GuildID where a user is expected is caught by the compiler instead of at runtime.
+type UserID Snowflake
+
+func (id UserID) String() string {
+	return Snowflake(id).String()
+}
+
+func (id UserID) IsZero() bool {
+	return Snowflake(id).IsZero()
+}
+
+func (id UserID) MarshalJSON() ([]byte, error) {
+	return Snowflake(id).MarshalJSON()
+}
+
+func (id *UserID) UnmarshalJSON(data []byte) error {
+	return (*Snowflake)(id).UnmarshalJSON(data)
+}
+
+// MessageID identifies a Message. It is a Snowflake restricted to message-shaped call sites, so
+// passing an unrelated ID where a message is expected is caught by the compiler instead of at
+// runtime.
+type MessageID Snowflake
+
+func (id MessageID) String() string {
+	return Snowflake(id).String()
+}
+
+func (id MessageID) IsZero() bool {
+	return Snowflake(id).IsZero()
+}
+
+func (id MessageID) MarshalJSON() ([]byte, error) {
+	return Snowflake(id).MarshalJSON()
+}
+
+func (id *MessageID) UnmarshalJSON(data []byte) error {
+	return (*Snowflake)(id).UnmarshalJSON(data)
+}
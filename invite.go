@@ -13,12 +13,24 @@ import (
 // }
 type PartialInvite = Invite
 
+// InviteCode identifies an Invite. Unlike entity IDs it is not a Snowflake, but wrapping it keeps
+// it from being swapped with an unrelated string argument at the call site.
+type InviteCode string
+
+func (c InviteCode) String() string {
+	return string(c)
+}
+
+func (c InviteCode) IsZero() bool {
+	return c == ""
+}
+
 // Invite Represents a code that when used, adds a user to a guild.
 // https://discord.com/developers/docs/resources/invite#invite-object
 // Reviewed: 2018-06-10
 type Invite struct {
 	// Code the invite code (unique Snowflake)
-	Code string `json:"code"`
+	Code InviteCode `json:"code"`
 
 	// Guild the guild this invite is for
 	Guild *PartialGuild `json:"guild"`
@@ -59,7 +71,7 @@ var _ DeepCopier = (*Invite)(nil)
 var _ discordDeleter = (*Invite)(nil)
 
 func (i *Invite) deleteFromDiscord(ctx context.Context, s Session, flags ...Flag) error {
-	if i.Code == "" {
+	if i.Code.IsZero() {
 		return &ErrorEmptyValue{info: "can not delete invite without the code field populate"}
 	}
 
@@ -167,6 +179,24 @@ func inviteFactory() interface{} {
 	return &Invite{}
 }
 
+// Cache registry keys for the invite-listing endpoints, mirroring GuildRoleCache/GuildRolesCache.
+const (
+	ChannelInvitesCache = "CHANNEL_INVITES"
+	GuildInvitesCache   = "GUILD_INVITES"
+)
+
+// getInvites unwraps the []*Invite returned by a REST execute, mirroring getInvite for the
+// list-returning invite endpoints.
+func getInvites(executor func() (interface{}, error)) (invites []*Invite, err error) {
+	var v interface{}
+	if v, err = executor(); err != nil {
+		return nil, err
+	}
+
+	invites = *v.(*[]*Invite)
+	return invites, nil
+}
+
 type GetInviteParams struct {
 	WithMemberCount bool `urlparam:"with_count,omitempty"`
 }
@@ -180,13 +210,13 @@ var _ URLQueryStringer = (*GetInviteParams)(nil)
 //  Reviewed                2018-06-10
 //  Comment                 -
 //  withMemberCount: whether or not the invite should contain the approximate number of members
-func (c *Client) GetInvite(ctx context.Context, inviteCode string, params URLQueryStringer, flags ...Flag) (invite *Invite, err error) {
+func (c *Client) GetInvite(ctx context.Context, inviteCode InviteCode, params URLQueryStringer, flags ...Flag) (invite *Invite, err error) {
 	if params == nil {
 		params = &GetInviteParams{}
 	}
 
 	r := c.newRESTRequest(&httd.Request{
-		Endpoint: endpoint.Invite(inviteCode) + params.URLQueryString(),
+		Endpoint: endpoint.Invite(string(inviteCode)) + params.URLQueryString(),
 		Ctx:      ctx,
 	}, flags)
 	r.factory = inviteFactory
@@ -200,12 +230,95 @@ func (c *Client) GetInvite(ctx context.Context, inviteCode string, params URLQue
 //  Discord documentation   https://discord.com/developers/docs/resources/invite#delete-invite
 //  Reviewed                2018-06-10
 //  Comment                 -
-func (c *Client) DeleteInvite(ctx context.Context, inviteCode string, flags ...Flag) (deleted *Invite, err error) {
+func (c *Client) DeleteInvite(ctx context.Context, inviteCode InviteCode, flags ...Flag) (deleted *Invite, err error) {
 	r := c.newRESTRequest(&httd.Request{
 		Method:   httd.MethodDelete,
-		Endpoint: endpoint.Invite(inviteCode),
+		Endpoint: endpoint.Invite(string(inviteCode)),
+		Ctx:      ctx,
+	}, flags)
+	r.factory = inviteFactory
+
+	return getInvite(r.Execute)
+}
+
+// GetChannelInvites [REST] Returns a list of invite objects (with invite metadata) for the channel.
+// Only usable for guild channels. Requires the 'MANAGE_CHANNELS' permission. The invite and invite
+// metadata fields (Uses, MaxUses, CreatedAt, ...) are populated directly on the returned objects.
+//  Method                  GET
+//  Endpoint                /channels/{channel.id}/invites
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#get-channel-invites
+//  Reviewed                2018-06-10
+//  Comment                 -
+func (c *Client) GetChannelInvites(ctx context.Context, channelID ChannelID, flags ...Flag) (invites []*Invite, err error) {
+	r := c.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.ChannelInvites(Snowflake(channelID)),
 		Ctx:      ctx,
 	}, flags)
+	r.CacheRegistry = ChannelInvitesCache
+	r.factory = func() interface{} {
+		tmp := make([]*Invite, 0)
+		return &tmp
+	}
+
+	return getInvites(r.Execute)
+}
+
+// GetGuildInvites [REST] Returns a list of invite objects (with invite metadata) for the guild.
+// Requires the 'MANAGE_GUILD' permission. The invite and invite metadata fields (Uses, MaxUses,
+// CreatedAt, ...) are populated directly on the returned objects.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/invites
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#get-guild-invites
+//  Reviewed                2018-06-10
+//  Comment                 -
+func (c *Client) GetGuildInvites(ctx context.Context, guildID GuildID, flags ...Flag) (invites []*Invite, err error) {
+	r := c.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildInvites(Snowflake(guildID)),
+		Ctx:      ctx,
+	}, flags)
+	r.CacheRegistry = GuildInvitesCache
+	r.factory = func() interface{} {
+		tmp := make([]*Invite, 0)
+		return &tmp
+	}
+
+	return getInvites(r.Execute)
+}
+
+// CreateChannelInviteParams ...
+// https://discord.com/developers/docs/resources/channel#create-channel-invite-json-params
+type CreateChannelInviteParams struct {
+	MaxAge         int    `json:"max_age,omitempty"`
+	MaxUses        int    `json:"max_uses,omitempty"`
+	Temporary      bool   `json:"temporary,omitempty"`
+	Unique         bool   `json:"unique,omitempty"`
+	TargetUser     UserID `json:"target_user,omitempty"`
+	TargetUserType int    `json:"target_user_type,omitempty"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+// CreateChannelInvite [REST] Create a new invite object for the channel. Only usable for guild
+// channels. Requires the CREATE_INSTANT_INVITE permission. Returns an invite object.
+//  Method                  POST
+//  Endpoint                /channels/{channel.id}/invites
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#create-channel-invite
+//  Reviewed                2018-06-10
+//  Comment                 All JSON params are optional.
+func (c *Client) CreateChannelInvite(ctx context.Context, channelID ChannelID, params *CreateChannelInviteParams, flags ...Flag) (invite *Invite, err error) {
+	if params == nil {
+		params = &CreateChannelInviteParams{}
+	}
+
+	r := c.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         ctx,
+		Endpoint:    endpoint.ChannelInvites(Snowflake(channelID)),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
 	r.factory = inviteFactory
 
 	return getInvite(r.Execute)
@@ -0,0 +1,177 @@
+package disgord
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PermissionBits is a Discord permission bitfield. It replaces the bare uint64 previously used on
+// Role, CreateGuildRoleParams and the member-permission resolvers, so that permission flags carry
+// their own helper methods and stay self-describing at the call site.
+// https://discord.com/developers/docs/topics/permissions
+type PermissionBits uint64
+
+// Named permission flags, in the order Discord documents them.
+const (
+	PermissionCreateInstantInvite PermissionBits = 1 << iota
+	PermissionKickMembers
+	PermissionBanMembers
+	PermissionAdministrator
+	PermissionManageChannels
+	PermissionManageGuild
+	PermissionAddReactions
+	PermissionViewAuditLog
+	PermissionPrioritySpeaker
+	PermissionStream
+	PermissionViewChannel
+	PermissionSendMessages
+	PermissionSendTTSMessages
+	PermissionManageMessages
+	PermissionEmbedLinks
+	PermissionAttachFiles
+	PermissionReadMessageHistory
+	PermissionMentionEveryone
+	PermissionUseExternalEmojis
+	PermissionViewGuildInsights
+	PermissionConnect
+	PermissionSpeak
+	PermissionMuteMembers
+	PermissionDeafenMembers
+	PermissionMoveMembers
+	PermissionUseVAD
+	PermissionChangeNickname
+	PermissionManageNicknames
+	PermissionManageRoles
+	PermissionManageWebhooks
+	PermissionManageEmojis
+	PermissionUseSlashCommands
+
+	// PermissionAll is the union of every named permission flag above.
+	PermissionAll = PermissionCreateInstantInvite | PermissionKickMembers | PermissionBanMembers |
+		PermissionAdministrator | PermissionManageChannels | PermissionManageGuild | PermissionAddReactions |
+		PermissionViewAuditLog | PermissionPrioritySpeaker | PermissionStream | PermissionViewChannel |
+		PermissionSendMessages | PermissionSendTTSMessages | PermissionManageMessages | PermissionEmbedLinks |
+		PermissionAttachFiles | PermissionReadMessageHistory | PermissionMentionEveryone | PermissionUseExternalEmojis |
+		PermissionViewGuildInsights | PermissionConnect | PermissionSpeak | PermissionMuteMembers |
+		PermissionDeafenMembers | PermissionMoveMembers | PermissionUseVAD | PermissionChangeNickname |
+		PermissionManageNicknames | PermissionManageRoles | PermissionManageWebhooks | PermissionManageEmojis |
+		PermissionUseSlashCommands
+)
+
+var permissionBitNames = []struct {
+	bit  PermissionBits
+	name string
+}{
+	{PermissionCreateInstantInvite, "CREATE_INSTANT_INVITE"},
+	{PermissionKickMembers, "KICK_MEMBERS"},
+	{PermissionBanMembers, "BAN_MEMBERS"},
+	{PermissionAdministrator, "ADMINISTRATOR"},
+	{PermissionManageChannels, "MANAGE_CHANNELS"},
+	{PermissionManageGuild, "MANAGE_GUILD"},
+	{PermissionAddReactions, "ADD_REACTIONS"},
+	{PermissionViewAuditLog, "VIEW_AUDIT_LOG"},
+	{PermissionPrioritySpeaker, "PRIORITY_SPEAKER"},
+	{PermissionStream, "STREAM"},
+	{PermissionViewChannel, "VIEW_CHANNEL"},
+	{PermissionSendMessages, "SEND_MESSAGES"},
+	{PermissionSendTTSMessages, "SEND_TTS_MESSAGES"},
+	{PermissionManageMessages, "MANAGE_MESSAGES"},
+	{PermissionEmbedLinks, "EMBED_LINKS"},
+	{PermissionAttachFiles, "ATTACH_FILES"},
+	{PermissionReadMessageHistory, "READ_MESSAGE_HISTORY"},
+	{PermissionMentionEveryone, "MENTION_EVERYONE"},
+	{PermissionUseExternalEmojis, "USE_EXTERNAL_EMOJIS"},
+	{PermissionViewGuildInsights, "VIEW_GUILD_INSIGHTS"},
+	{PermissionConnect, "CONNECT"},
+	{PermissionSpeak, "SPEAK"},
+	{PermissionMuteMembers, "MUTE_MEMBERS"},
+	{PermissionDeafenMembers, "DEAFEN_MEMBERS"},
+	{PermissionMoveMembers, "MOVE_MEMBERS"},
+	{PermissionUseVAD, "USE_VAD"},
+	{PermissionChangeNickname, "CHANGE_NICKNAME"},
+	{PermissionManageNicknames, "MANAGE_NICKNAMES"},
+	{PermissionManageRoles, "MANAGE_ROLES"},
+	{PermissionManageWebhooks, "MANAGE_WEBHOOKS"},
+	{PermissionManageEmojis, "MANAGE_EMOJIS"},
+	{PermissionUseSlashCommands, "USE_SLASH_COMMANDS"},
+}
+
+// Has returns true if every bit in flag is set.
+func (p PermissionBits) Has(flag PermissionBits) bool {
+	return p&flag == flag
+}
+
+// Add returns a copy of p with the given flags set.
+func (p PermissionBits) Add(flags ...PermissionBits) PermissionBits {
+	for _, flag := range flags {
+		p |= flag
+	}
+	return p
+}
+
+// Remove returns a copy of p with the given flags cleared.
+func (p PermissionBits) Remove(flags ...PermissionBits) PermissionBits {
+	for _, flag := range flags {
+		p &^= flag
+	}
+	return p
+}
+
+// Contains returns true if p has every flag in all set.
+func (p PermissionBits) Contains(all ...PermissionBits) bool {
+	for _, flag := range all {
+		if !p.Has(flag) {
+			return false
+		}
+	}
+	return true
+}
+
+// Any returns true if p has at least one of the given flags set.
+func (p PermissionBits) Any(flags ...PermissionBits) bool {
+	for _, flag := range flags {
+		if p&flag != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// String lists the named permission flags set on p, separated by "|".
+func (p PermissionBits) String() string {
+	if p == 0 {
+		return "NONE"
+	}
+
+	names := make([]string, 0, len(permissionBitNames))
+	for _, entry := range permissionBitNames {
+		if p.Has(entry.bit) {
+			names = append(names, entry.name)
+		}
+	}
+
+	return strings.Join(names, "|")
+}
+
+// MarshalJSON encodes the permission bitfield as a stringified integer, matching the
+// permissions-v2 wire format Discord uses for values beyond 2^53.
+func (p PermissionBits) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + strconv.FormatUint(uint64(p), 10) + `"`), nil
+}
+
+// UnmarshalJSON accepts both the stringified integer form Discord sends for permissions-v2 and the
+// legacy bare numeric form.
+func (p *PermissionBits) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*p = 0
+		return nil
+	}
+
+	v, err := strconv.ParseUint(strings.Trim(string(data), `"`), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*p = PermissionBits(v)
+	return nil
+}
@@ -0,0 +1,82 @@
+package disgord
+
+import "testing"
+
+func TestPermissionBitsUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    PermissionBits
+		wantErr bool
+	}{
+		{name: "legacy bare numeric form", data: `54`, want: 54},
+		{name: "permissions-v2 stringified form", data: `"54"`, want: 54},
+		{name: "value above 2^53", data: `"9223372036854775807"`, want: 9223372036854775807},
+		{name: "null is treated as zero", data: `null`, want: 0},
+		{name: "non-numeric input is an error", data: `"not-a-number"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got PermissionBits
+			err := got.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("UnmarshalJSON(%s) expected an error, got nil", tt.data)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UnmarshalJSON(%s) returned unexpected error: %v", tt.data, err)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalJSON(%s) = %d, want %d", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPermissionBitsMarshalJSON(t *testing.T) {
+	data, err := PermissionBits(54).MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() returned unexpected error: %v", err)
+	}
+
+	want := `"54"`
+	if string(data) != want {
+		t.Errorf("MarshalJSON() = %s, want %s", data, want)
+	}
+}
+
+func TestPermissionBitsHasAddRemove(t *testing.T) {
+	p := PermissionBits(0).Add(PermissionViewChannel, PermissionSendMessages)
+
+	if !p.Has(PermissionViewChannel) || !p.Has(PermissionSendMessages) {
+		t.Fatalf("Add() did not set both flags, got %s", p)
+	}
+
+	p = p.Remove(PermissionSendMessages)
+	if p.Has(PermissionSendMessages) {
+		t.Errorf("Remove() did not clear PermissionSendMessages, got %s", p)
+	}
+	if !p.Has(PermissionViewChannel) {
+		t.Errorf("Remove() unexpectedly cleared PermissionViewChannel, got %s", p)
+	}
+}
+
+func TestPermissionBitsContainsAndAny(t *testing.T) {
+	p := PermissionViewChannel | PermissionSendMessages
+
+	if !p.Contains(PermissionViewChannel, PermissionSendMessages) {
+		t.Errorf("Contains() = false, want true")
+	}
+	if p.Contains(PermissionViewChannel, PermissionManageGuild) {
+		t.Errorf("Contains() = true, want false")
+	}
+	if !p.Any(PermissionManageGuild, PermissionSendMessages) {
+		t.Errorf("Any() = false, want true")
+	}
+	if p.Any(PermissionManageGuild, PermissionKickMembers) {
+		t.Errorf("Any() = true, want false")
+	}
+}
@@ -48,16 +48,16 @@ func NewRole() *Role {
 
 // Role https://discord.com/developers/docs/topics/permissions#role-object
 type Role struct {
-	ID          Snowflake `json:"id"`
-	Name        string    `json:"name"`
-	Color       uint      `json:"color"`
-	Hoist       bool      `json:"hoist"`
-	Position    int       `json:"position"` // can be -1
-	Permissions uint64    `json:"permissions"`
-	Managed     bool      `json:"managed"`
-	Mentionable bool      `json:"mentionable"`
-
-	guildID Snowflake
+	ID          RoleID         `json:"id"`
+	Name        string         `json:"name"`
+	Color       uint           `json:"color"`
+	Hoist       bool           `json:"hoist"`
+	Position    int            `json:"position"` // can be -1
+	Permissions PermissionBits `json:"permissions"`
+	Managed     bool           `json:"managed"`
+	Mentionable bool           `json:"mentionable"`
+
+	guildID GuildID
 }
 
 var _ Mentioner = (*Role)(nil)
@@ -77,7 +77,7 @@ func (r *Role) Mention() string {
 }
 
 // SetGuildID link role to a guild before running session.SaveToDiscord(*Role)
-func (r *Role) SetGuildID(id Snowflake) {
+func (r *Role) SetGuildID(id GuildID) {
 	r.guildID = id
 }
 
@@ -133,11 +133,11 @@ func (r *Role) deleteFromDiscord(ctx context.Context, s Session, flags ...Flag)
 // CreateGuildRoleParams ...
 // https://discord.com/developers/docs/resources/guild#create-guild-role-json-params
 type CreateGuildRoleParams struct {
-	Name        string `json:"name,omitempty"`
-	Permissions uint64 `json:"permissions,omitempty"`
-	Color       uint   `json:"color,omitempty"`
-	Hoist       bool   `json:"hoist,omitempty"`
-	Mentionable bool   `json:"mentionable,omitempty"`
+	Name        string         `json:"name,omitempty"`
+	Permissions PermissionBits `json:"permissions,omitempty"`
+	Color       uint           `json:"color,omitempty"`
+	Hoist       bool           `json:"hoist,omitempty"`
+	Mentionable bool           `json:"mentionable,omitempty"`
 
 	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
 	Reason string `json:"-"`
@@ -150,11 +150,11 @@ type CreateGuildRoleParams struct {
 //  Discord documentation   https://discord.com/developers/docs/resources/guild#create-guild-role
 //  Reviewed                2018-08-18
 //  Comment                 All JSON params are optional.
-func (c *Client) CreateGuildRole(ctx context.Context, id Snowflake, params *CreateGuildRoleParams, flags ...Flag) (ret *Role, err error) {
+func (c *Client) CreateGuildRole(ctx context.Context, guildID GuildID, params *CreateGuildRoleParams, flags ...Flag) (ret *Role, err error) {
 	r := c.newRESTRequest(&httd.Request{
 		Method:      httd.MethodPost,
 		Ctx:         ctx,
-		Endpoint:    endpoint.GuildRoles(id),
+		Endpoint:    endpoint.GuildRoles(Snowflake(guildID)),
 		Body:        params,
 		ContentType: httd.ContentTypeJSON,
 		Reason:      params.Reason,
@@ -165,7 +165,7 @@ func (c *Client) CreateGuildRole(ctx context.Context, id Snowflake, params *Crea
 	}
 	r.preUpdateCache = func(x interface{}) {
 		r := x.(*Role)
-		r.guildID = id
+		r.guildID = guildID
 	}
 
 	return getRole(r.Execute)
@@ -178,7 +178,7 @@ func (c *Client) CreateGuildRole(ctx context.Context, id Snowflake, params *Crea
 //  Discord documentation   https://discord.com/developers/docs/resources/guild#modify-guild-role
 //  Reviewed                2018-08-18
 //  Comment                 -
-func (c *Client) UpdateGuildRole(ctx context.Context, guildID, roleID Snowflake, flags ...Flag) (builder *updateGuildRoleBuilder) {
+func (c *Client) UpdateGuildRole(ctx context.Context, guildID GuildID, roleID RoleID, flags ...Flag) (builder *updateGuildRoleBuilder) {
 	builder = &updateGuildRoleBuilder{}
 	builder.r.itemFactory = func() interface{} {
 		return &Role{}
@@ -187,7 +187,7 @@ func (c *Client) UpdateGuildRole(ctx context.Context, guildID, roleID Snowflake,
 	builder.r.IgnoreCache().setup(c.cache, c.req, &httd.Request{
 		Method:      httd.MethodPatch,
 		Ctx:         ctx,
-		Endpoint:    endpoint.GuildRole(guildID, roleID),
+		Endpoint:    endpoint.GuildRole(Snowflake(guildID), Snowflake(roleID)),
 		ContentType: httd.ContentTypeJSON,
 	}, nil)
 
@@ -207,10 +207,10 @@ func (c *Client) UpdateGuildRole(ctx context.Context, guildID, roleID Snowflake,
 //  Discord documentation   https://discord.com/developers/docs/resources/guild#delete-guild-role
 //  Reviewed                2018-08-18
 //  Comment                 -
-func (c *Client) DeleteGuildRole(ctx context.Context, guildID, roleID Snowflake, flags ...Flag) (err error) {
+func (c *Client) DeleteGuildRole(ctx context.Context, guildID GuildID, roleID RoleID, flags ...Flag) (err error) {
 	r := c.newRESTRequest(&httd.Request{
 		Method:   httd.MethodDelete,
-		Endpoint: endpoint.GuildRole(guildID, roleID),
+		Endpoint: endpoint.GuildRole(Snowflake(guildID), Snowflake(roleID)),
 		Ctx:      ctx,
 	}, flags)
 	r.expectsStatusCode = http.StatusNoContent
@@ -225,7 +225,7 @@ func (c *Client) DeleteGuildRole(ctx context.Context, guildID, roleID Snowflake,
 //  Discord documentation   https://discord.com/developers/docs/resources/guild#get-guild-roles
 //  Reviewed                2018-08-18
 //  Comment                 -
-func (c *Client) GetGuildRoles(ctx context.Context, guildID Snowflake, flags ...Flag) (ret []*Role, err error) {
+func (c *Client) GetGuildRoles(ctx context.Context, guildID GuildID, flags ...Flag) (ret []*Role, err error) {
 	r := c.newRESTRequest(&httd.Request{
 		Endpoint: "/guilds/" + guildID.String() + "/roles",
 		Ctx:      ctx,
@@ -245,8 +245,83 @@ func (c *Client) GetGuildRoles(ctx context.Context, guildID Snowflake, flags ...
 	return getRoles(r.Execute)
 }
 
-// GetMemberPermissions populates a uint64 with all the permission flags
-func (c *Client) GetMemberPermissions(ctx context.Context, guildID, userID Snowflake, flags ...Flag) (permissions PermissionBits, err error) {
+// UpdateGuildRolePositionsParams ...
+// https://discord.com/developers/docs/resources/guild#modify-guild-role-positions-json-params
+type UpdateGuildRolePositionsParams struct {
+	ID       RoleID `json:"id"`
+	Position int    `json:"position"`
+}
+
+// UpdateGuildRolePositions [REST] Modify the positions of a set of role objects for the guild.
+// Requires the 'MANAGE_ROLES' permission. Returns a list of all the guild's role objects on
+// success. Fires multiple Guild Role Update Gateway events.
+//  Method                  PATCH
+//  Endpoint                /guilds/{guild.id}/roles
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#modify-guild-role-positions
+//  Reviewed                2021-02-14
+//  Comment                 -
+func (c *Client) UpdateGuildRolePositions(ctx context.Context, guildID GuildID, params []UpdateGuildRolePositionsParams, flags ...Flag) (ret []*Role, err error) {
+	r := c.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPatch,
+		Ctx:         ctx,
+		Endpoint:    endpoint.GuildRoles(Snowflake(guildID)),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+	}, flags)
+	r.CacheRegistry = GuildRolesCache
+	r.factory = func() interface{} {
+		tmp := make([]*Role, 0)
+		return &tmp
+	}
+	r.preUpdateCache = func(x interface{}) {
+		roles := *x.(*[]*Role)
+		for i := range roles {
+			roles[i].guildID = guildID
+		}
+	}
+
+	return getRoles(r.Execute)
+}
+
+// ReorderRoles is a convenience wrapper around UpdateGuildRolePositions: it derives the position
+// payload from an already-ordered slice of roles, e.g. the output of SortRoles, so a caller can
+// sort locally, mutate the order, and flush it to Discord in a single call. The @everyone role
+// (its ID equals the guild ID) always sits at position 0 and cannot be reordered, so it is skipped
+// if present in rs; the remaining roles are assigned positions counting down from their count.
+func (c *Client) ReorderRoles(ctx context.Context, guildID GuildID, rs []*Role, flags ...Flag) (ret []*Role, err error) {
+	params := reorderRolesPositions(guildID, rs)
+	return c.UpdateGuildRolePositions(ctx, guildID, params, flags...)
+}
+
+// reorderRolesPositions derives the UpdateGuildRolePositions payload from an already-ordered slice
+// of roles, skipping the immovable @everyone role (its ID equals the guild ID) and assigning the
+// remaining roles positions counting down from their count.
+func reorderRolesPositions(guildID GuildID, rs []*Role) []UpdateGuildRolePositionsParams {
+	movable := make([]*Role, 0, len(rs))
+	for _, role := range rs {
+		if Snowflake(role.ID) == Snowflake(guildID) {
+			continue
+		}
+		movable = append(movable, role)
+	}
+
+	params := make([]UpdateGuildRolePositionsParams, len(movable))
+	for i, role := range movable {
+		params[i] = UpdateGuildRolePositionsParams{
+			ID:       role.ID,
+			Position: len(movable) - i,
+		}
+	}
+
+	return params
+}
+
+// GetMemberPermissions populates a PermissionBits with all the permission flags the member holds
+// across their roles. It does not account for channel overwrites; see GetMemberPermissionsInChannel.
+// It is a thin wrapper around resolveBasePermissions: this method never fetches the guild owner, so
+// it passes a nil ownerID and the owner short-circuit is skipped, but the ADMINISTRATOR
+// short-circuit still applies and can resolve permissions to PermissionAll.
+func (c *Client) GetMemberPermissions(ctx context.Context, guildID GuildID, userID UserID, flags ...Flag) (permissions PermissionBits, err error) {
 	roles, err := c.GetGuildRoles(ctx, guildID, flags...)
 	if err != nil {
 		return 0, err
@@ -257,22 +332,112 @@ func (c *Client) GetMemberPermissions(ctx context.Context, guildID, userID Snowf
 		return 0, err
 	}
 
-	roleIDs := member.Roles
-	for i := range roles {
-		for j := range roleIDs {
-			if roles[i].ID == roleIDs[j] {
-				permissions |= roles[i].Permissions
-				roleIDs = roleIDs[:j+copy(roleIDs[j:], roleIDs[j+1:])]
-				break
-			}
+	memberRoles := make(map[RoleID]bool, len(member.Roles))
+	for _, id := range member.Roles {
+		memberRoles[RoleID(id)] = true
+	}
+
+	permissions, _ = resolveBasePermissions(guildID, nil, userID, roles, memberRoles)
+	return permissions, nil
+}
+
+// Overwrite type values for PermissionOverwrite.Type, disambiguating role and member overwrites
+// whose IDs share the global Snowflake space with channels and guilds.
+// https://discord.com/developers/docs/resources/channel#overwrite-object
+const (
+	OverwriteRole   = 0
+	OverwriteMember = 1
+)
+
+// GetMemberPermissionsInChannel resolves a member's effective permissions in a specific channel.
+// It ORs the @everyone role with every role the member holds, short-circuits to PermissionAll if
+// the member is the guild owner or holds ADMINISTRATOR, and otherwise applies the channel's
+// permission overwrites in the order Discord documents: the @everyone overwrite, then the union of
+// overwrites for the member's roles, then the member-specific overwrite - deny before allow at
+// each step. Overwrites are classified by their Type field rather than ID alone, since role, member,
+// channel and guild IDs all come from the same Snowflake space.
+// https://discord.com/developers/docs/topics/permissions#permission-overwrites
+func (c *Client) GetMemberPermissionsInChannel(ctx context.Context, guildID GuildID, channelID ChannelID, userID UserID, flags ...Flag) (permissions PermissionBits, err error) {
+	guild, err := c.GetGuild(ctx, guildID, flags...)
+	if err != nil {
+		return 0, err
+	}
+
+	member, err := c.GetMember(ctx, guildID, userID, flags...)
+	if err != nil {
+		return 0, err
+	}
+
+	memberRoles := make(map[RoleID]bool, len(member.Roles))
+	for _, id := range member.Roles {
+		memberRoles[RoleID(id)] = true
+	}
+
+	permissions, shortCircuited := resolveBasePermissions(guildID, &guild.OwnerID, userID, guild.Roles, memberRoles)
+	if shortCircuited {
+		return permissions, nil
+	}
+
+	channel, err := c.GetChannel(ctx, channelID, flags...)
+	if err != nil {
+		return 0, err
+	}
+
+	var everyoneAllow, everyoneDeny PermissionBits
+	var roleAllow, roleDeny PermissionBits
+	var memberAllow, memberDeny PermissionBits
+
+	for _, overwrite := range channel.PermissionOverwrites {
+		switch {
+		case overwrite.Type == OverwriteRole && overwrite.ID == Snowflake(guildID):
+			everyoneAllow = PermissionBits(overwrite.Allow)
+			everyoneDeny = PermissionBits(overwrite.Deny)
+		case overwrite.Type == OverwriteRole && memberRoles[RoleID(overwrite.ID)]:
+			roleAllow = roleAllow.Add(PermissionBits(overwrite.Allow))
+			roleDeny = roleDeny.Add(PermissionBits(overwrite.Deny))
+		case overwrite.Type == OverwriteMember && overwrite.ID == Snowflake(userID):
+			memberAllow = PermissionBits(overwrite.Allow)
+			memberDeny = PermissionBits(overwrite.Deny)
 		}
+	}
+
+	return resolveChannelOverwrites(permissions, everyoneAllow, everyoneDeny, roleAllow, roleDeny, memberAllow, memberDeny), nil
+}
 
-		if len(roleIDs) == 0 {
-			break
+// resolveBasePermissions ORs the @everyone role with every role in roles that the member holds,
+// then applies the guild-owner and ADMINISTRATOR short-circuits. ownerID is nil when the caller has
+// no guild-owner information to check (e.g. GetMemberPermissions, which stays role-only), as
+// opposed to an owner whose Snowflake happens to be zero; the two must not be conflated.
+// shortCircuited is true when permissions is already final (PermissionAll) and channel overwrites
+// must not be applied.
+func resolveBasePermissions(guildID GuildID, ownerID *Snowflake, userID UserID, roles []*Role, memberRoles map[RoleID]bool) (permissions PermissionBits, shortCircuited bool) {
+	if ownerID != nil && *ownerID == Snowflake(userID) {
+		return PermissionAll, true
+	}
+
+	for _, role := range roles {
+		if Snowflake(role.ID) == Snowflake(guildID) || memberRoles[role.ID] {
+			permissions = permissions.Add(role.Permissions)
 		}
 	}
 
-	return permissions, nil
+	if permissions.Has(PermissionAdministrator) {
+		return PermissionAll, true
+	}
+
+	return permissions, false
+}
+
+// resolveChannelOverwrites layers channel permission overwrites onto base in the order Discord
+// documents: the @everyone overwrite, then the union of the member's role overwrites, then the
+// member-specific overwrite - deny before allow at each step.
+func resolveChannelOverwrites(base, everyoneAllow, everyoneDeny, roleAllow, roleDeny, memberAllow, memberDeny PermissionBits) PermissionBits {
+	permissions := base
+	permissions = permissions.Remove(everyoneDeny).Add(everyoneAllow)
+	permissions = permissions.Remove(roleDeny).Add(roleAllow)
+	permissions = permissions.Remove(memberDeny).Add(memberAllow)
+
+	return permissions
 }
 
 //////////////////////////////////////////////////////
@@ -0,0 +1,135 @@
+package disgord
+
+import "testing"
+
+func TestResolveBasePermissions(t *testing.T) {
+	guildID := GuildID(1)
+	everyone := &Role{ID: RoleID(guildID), Permissions: PermissionViewChannel}
+	memberRole := &Role{ID: RoleID(2), Permissions: PermissionSendMessages}
+	adminRole := &Role{ID: RoleID(3), Permissions: PermissionAdministrator}
+	otherRole := &Role{ID: RoleID(4), Permissions: PermissionManageGuild}
+
+	snowflake := func(id Snowflake) *Snowflake { return &id }
+
+	tests := []struct {
+		name             string
+		ownerID          *Snowflake
+		userID           UserID
+		roles            []*Role
+		memberRoles      map[RoleID]bool
+		wantPermissions  PermissionBits
+		wantShortCircuit bool
+	}{
+		{
+			name:             "owner short-circuits to PermissionAll",
+			ownerID:          snowflake(42),
+			userID:           UserID(42),
+			roles:            []*Role{everyone, otherRole},
+			memberRoles:      map[RoleID]bool{},
+			wantPermissions:  PermissionAll,
+			wantShortCircuit: true,
+		},
+		{
+			name:             "administrator role short-circuits to PermissionAll",
+			ownerID:          snowflake(99),
+			userID:           UserID(42),
+			roles:            []*Role{everyone, adminRole},
+			memberRoles:      map[RoleID]bool{adminRole.ID: true},
+			wantPermissions:  PermissionAll,
+			wantShortCircuit: true,
+		},
+		{
+			name:             "everyone and held roles are ORed together",
+			ownerID:          snowflake(99),
+			userID:           UserID(42),
+			roles:            []*Role{everyone, memberRole, otherRole},
+			memberRoles:      map[RoleID]bool{memberRole.ID: true},
+			wantPermissions:  PermissionViewChannel | PermissionSendMessages,
+			wantShortCircuit: false,
+		},
+		{
+			name:             "nil ownerID never short-circuits, even for a zero-value userID",
+			ownerID:          nil,
+			userID:           UserID(0),
+			roles:            []*Role{everyone, memberRole, otherRole},
+			memberRoles:      map[RoleID]bool{memberRole.ID: true},
+			wantPermissions:  PermissionViewChannel | PermissionSendMessages,
+			wantShortCircuit: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, shortCircuited := resolveBasePermissions(guildID, tt.ownerID, tt.userID, tt.roles, tt.memberRoles)
+			if shortCircuited != tt.wantShortCircuit {
+				t.Errorf("shortCircuited = %v, want %v", shortCircuited, tt.wantShortCircuit)
+			}
+			if got != tt.wantPermissions {
+				t.Errorf("permissions = %s, want %s", got, tt.wantPermissions)
+			}
+		})
+	}
+}
+
+func TestResolveChannelOverwrites(t *testing.T) {
+	base := PermissionViewChannel | PermissionSendMessages | PermissionManageMessages
+
+	// @everyone denies SendMessages, the member's role allows it back, and the member-specific
+	// overwrite denies ManageMessages - each layer must be applied in order for the net result to
+	// come out right.
+	got := resolveChannelOverwrites(base,
+		/* everyoneAllow */ 0, /* everyoneDeny */ PermissionSendMessages,
+		/* roleAllow */ PermissionSendMessages, /* roleDeny */ 0,
+		/* memberAllow */ 0, /* memberDeny */ PermissionManageMessages,
+	)
+
+	want := PermissionViewChannel | PermissionSendMessages
+	if got != want {
+		t.Errorf("resolveChannelOverwrites() = %s, want %s", got, want)
+	}
+}
+
+func TestReorderRolesPositions(t *testing.T) {
+	guildID := GuildID(1)
+	everyone := &Role{ID: RoleID(guildID)}
+	roleA := &Role{ID: RoleID(2)}
+	roleB := &Role{ID: RoleID(3)}
+	roleC := &Role{ID: RoleID(4)}
+
+	// @everyone mixed into rs, as SortRoles/GetGuildRoles would return it - it must be excluded
+	// from the position payload rather than counted, or the remaining roles' positions shift by one.
+	params := reorderRolesPositions(guildID, []*Role{everyone, roleA, roleB, roleC})
+
+	if len(params) != 3 {
+		t.Fatalf("len(params) = %d, want 3 (the @everyone role must be excluded)", len(params))
+	}
+
+	for _, p := range params {
+		if Snowflake(p.ID) == Snowflake(guildID) {
+			t.Errorf("params contains the @everyone role %s, it must be excluded", p.ID)
+		}
+	}
+
+	want := map[RoleID]int{roleA.ID: 3, roleB.ID: 2, roleC.ID: 1}
+	for _, p := range params {
+		if p.Position != want[p.ID] {
+			t.Errorf("position for role %s = %d, want %d", p.ID, p.Position, want[p.ID])
+		}
+	}
+}
+
+func TestResolveChannelOverwritesMemberTakesPrecedenceOverRole(t *testing.T) {
+	base := PermissionSendMessages
+
+	// The role overwrite allows SendMessages, but the member-specific overwrite denies it - the
+	// member overwrite is applied last and must win.
+	got := resolveChannelOverwrites(base,
+		0, 0,
+		PermissionSendMessages, 0,
+		0, PermissionSendMessages,
+	)
+
+	if got.Has(PermissionSendMessages) {
+		t.Errorf("resolveChannelOverwrites() = %s, want SendMessages denied", got)
+	}
+}